@@ -18,8 +18,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 )
 
 // SimpleArtist contains basic info about an artist.
@@ -53,9 +56,22 @@ type FullArtist struct {
 	// Information about followers of the artist.
 	Followers Followers
 	// Images of the artist in various sizes, widest first.
-	Images []Image `json:"images"`
+	Images Images `json:"images"`
 }
 
+// Images is a list of images of varying sizes, as returned for an
+// artist, album, or playlist, widest first.
+type Images []Image
+
+// Commonly requested artist image sizes, in pixels, matching the
+// small/medium/large avatar sizes used by most Last.fm-compatible
+// artist-info clients.
+const (
+	ImageSizeSmall  = 64
+	ImageSizeMedium = 174
+	ImageSizeLarge  = 300
+)
+
 // FindArtist is a wrapper around DefaultClient.FindArtist.
 func FindArtist(id ID) (*FullArtist, error) {
 	return DefaultClient.FindArtist(id)
@@ -65,7 +81,7 @@ func FindArtist(id ID) (*FullArtist, error) {
 // artist, given that artist's Spotify ID.
 func (c *Client) FindArtist(id ID) (*FullArtist, error) {
 	uri := baseAddress + "artists/" + string(id)
-	resp, err := c.http.Get(uri)
+	resp, err := c.doGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +110,7 @@ func FindArtists(ids ...ID) ([]*FullArtist, error) {
 // duplicate artists in the result.
 func (c *Client) FindArtists(ids ...ID) ([]*FullArtist, error) {
 	uri := baseAddress + "artists?ids=" + strings.Join(toStringSlice(ids), ",")
-	resp, err := c.http.Get(uri)
+	resp, err := c.doGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +128,80 @@ func (c *Client) FindArtists(ids ...ID) ([]*FullArtist, error) {
 	return a.Artists, nil
 }
 
+// artistsBatchSize is the most artist IDs Spotify will accept in a
+// single call to the artists endpoint.
+const artistsBatchSize = 50
+
+// DefaultBatchConcurrency is the number of in-flight chunk requests
+// FindArtistsBatch issues at once when it has not been overridden with
+// SetBatchConcurrency.
+const DefaultBatchConcurrency = 4
+
+// SetBatchConcurrency configures how many chunk requests
+// FindArtistsBatch is allowed to have in flight at once.  A value <= 0
+// falls back to DefaultBatchConcurrency.  Like SetRetryPolicy, it is
+// meant to be called once during setup, before c is shared across
+// goroutines.
+func (c *Client) SetBatchConcurrency(n int) {
+	c.batchConcurrency = n
+}
+
+// FindArtistsBatch is like FindArtists, but accepts an ids slice of any
+// length.  Internally it is split into chunks of at most 50 (the limit
+// Spotify enforces per request), the chunks are requested concurrently
+// through a bounded worker pool, and the results are reassembled in the
+// original request order.  As with FindArtists, if an artist is not
+// found, that position in the result will be nil.
+//
+// This is the entry point bulk-enrichment workloads - for example,
+// resolving every artist in a large library - should use instead of
+// reimplementing chunking, ordering, and error aggregation themselves.
+func (c *Client) FindArtistsBatch(ids ...ID) ([]*FullArtist, error) {
+	if len(ids) <= artistsBatchSize {
+		return c.FindArtists(ids...)
+	}
+
+	concurrency := c.batchConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	chunks := chunkIDs(ids, artistsBatchSize)
+	artists := make([][]*FullArtist, len(chunks))
+	errs := make([]error, len(chunks))
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, chunk []ID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			artists[i], errs[i] = c.FindArtists(chunk...)
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	result := make([]*FullArtist, 0, len(ids))
+	for i, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, artists[i]...)
+	}
+	return result, nil
+}
+
+// chunkIDs splits ids into consecutive slices of at most size elements.
+func chunkIDs(ids []ID, size int) [][]ID {
+	var chunks [][]ID
+	for size < len(ids) {
+		ids, chunks = ids[size:], append(chunks, ids[0:size:size])
+	}
+	return append(chunks, ids)
+}
+
 // ArtistsTopTracks is a wrapper around DefaultClient.ArtistTopTracks.
 func ArtistsTopTracks(artistID ID, country string) ([]FullTrack, error) {
 	return DefaultClient.ArtistsTopTracks(artistID, country)
@@ -123,7 +213,7 @@ func ArtistsTopTracks(artistID ID, country string) ([]FullTrack, error) {
 // ISO 3166-1 alpha-2 country code.
 func (c *Client) ArtistsTopTracks(artistID ID, country string) ([]FullTrack, error) {
 	uri := baseAddress + "artists/" + string(artistID) + "/top-tracks?country=" + country
-	resp, err := c.http.Get(uri)
+	resp, err := c.doGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +244,7 @@ func FindRelatedArtists(id ID) ([]FullArtist, error) {
 // related to the specified artist.
 func (c *Client) FindRelatedArtists(id ID) ([]FullArtist, error) {
 	uri := baseAddress + "artists/" + string(id) + "/related-artists"
-	resp, err := c.http.Get(uri)
+	resp, err := c.doGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +310,14 @@ func (c *Client) ArtistAlbumsOpt(artistID ID, options *Options, t *AlbumType) (*
 			uri += "?" + query
 		}
 	}
-	resp, err := c.http.Get(uri)
+	return c.fetchAlbumPage(uri)
+}
+
+// fetchAlbumPage issues a GET against uri and decodes the result into a
+// SimpleAlbumPage.  It is shared by ArtistAlbumsOpt and AlbumIterator,
+// the latter of which re-invokes it against each page's Next URL.
+func (c *Client) fetchAlbumPage(uri string) (*SimpleAlbumPage, error) {
+	resp, err := c.doGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -247,3 +344,269 @@ func (c *Client) ArtistAlbumsOpt(artistID ID, options *Options, t *AlbumType) (*
 	result.Next = p.Next
 	return &result, nil
 }
+
+// AlbumIterator walks an artist's entire discography, transparently
+// re-issuing requests against each page's Next URL until Spotify stops
+// returning one.  Use ArtistAlbumsAll to obtain an AlbumIterator.
+type AlbumIterator struct {
+	c       *Client
+	page    *SimpleAlbumPage
+	index   int
+	current SimpleAlbum
+	err     error
+}
+
+// ArtistAlbumsAll returns an AlbumIterator that walks every album for
+// artistID, re-issuing GETs against the Next URL of each page as the
+// caller advances it.  t and opts behave as they do in ArtistAlbumsOpt;
+// opts.Limit, if set, controls the page size used while walking.
+func (c *Client) ArtistAlbumsAll(artistID ID, t *AlbumType, opts *Options) *AlbumIterator {
+	page, err := c.ArtistAlbumsOpt(artistID, opts, t)
+	return &AlbumIterator{c: c, page: page, index: -1, err: err}
+}
+
+// Next advances the iterator to the next album, fetching the next page
+// from Spotify if the current page has been exhausted.  It returns false
+// when there are no more albums or an error occurred; callers should
+// check Err to distinguish the two.
+func (it *AlbumIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.page != nil && it.index >= len(it.page.Albums) {
+		if it.page.Next == "" {
+			return false
+		}
+		it.page, it.err = it.c.fetchAlbumPage(it.page.Next)
+		if it.err != nil {
+			return false
+		}
+		it.index = 0
+	}
+	if it.page == nil || it.index >= len(it.page.Albums) {
+		return false
+	}
+	it.current = it.page.Albums[it.index]
+	return true
+}
+
+// Album returns the album the iterator is currently positioned at. It
+// should only be called after a call to Next returns true.
+func (it *AlbumIterator) Album() SimpleAlbum {
+	return it.current
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *AlbumIterator) Err() error {
+	return it.err
+}
+
+// ArtistImages gets the images associated with a single artist,
+// given that artist's Spotify ID, widest first.
+func (c *Client) ArtistImages(id ID) (Images, error) {
+	a, err := c.FindArtist(id)
+	if err != nil {
+		return nil, err
+	}
+	return a.Images, nil
+}
+
+// ClosestTo returns a pointer to the image in imgs whose width is
+// closest to the requested width, saving callers from having to sort
+// and reason about the "widest first" ordering Spotify returns images
+// in.  It returns nil if imgs is empty.
+func (imgs Images) ClosestTo(width int) *Image {
+	if len(imgs) == 0 {
+		return nil
+	}
+	closest := &imgs[0]
+	closestDiff := absInt(closest.Width - width)
+	for i := 1; i < len(imgs); i++ {
+		if diff := absInt(imgs[i].Width - width); diff < closestDiff {
+			closest = &imgs[i]
+			closestDiff = diff
+		}
+	}
+	return closest
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// searchArtistsMaxLimit is the largest limit value the /search endpoint
+// accepts.
+const searchArtistsMaxLimit = 50
+
+// SearchArtists searches the Spotify catalog for artists matching name
+// and returns up to limit results, ordered by how closely each artist's
+// name matches the query.
+//
+// Spotify's own relevance ranking frequently surfaces the wrong artist
+// for short or ambiguous names (e.g. "a-ha" or "Ash"), so the results
+// of the underlying search are re-ranked in-package by string similarity
+// (Jaro-Winkler) between the normalized query and each candidate's name.
+// Ties are broken in favor of the more popular artist.
+//
+// The re-rank can only promote a candidate that Spotify actually
+// returned, so SearchArtists always fetches a generous candidate pool
+// (searchArtistsMaxLimit) from /search regardless of limit, and only
+// applies limit to the final, re-ranked results.
+func (c *Client) SearchArtists(name string, limit int) ([]FullArtist, error) {
+	uri := baseAddress + "search?type=artist&q=" + url.QueryEscape(name) +
+		"&limit=" + strconv.Itoa(searchArtistsMaxLimit)
+	resp, err := c.doGet(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp.Body)
+	}
+	var result struct {
+		Artists struct {
+			Items []FullArtist `json:"items"`
+		} `json:"artists"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	artists := result.Artists.Items
+	normalized := foldToLowerASCII(name)
+	sort.SliceStable(artists, func(i, j int) bool {
+		si := jaroWinkler(normalized, foldToLowerASCII(artists[i].Name))
+		sj := jaroWinkler(normalized, foldToLowerASCII(artists[j].Name))
+		if si != sj {
+			return si > sj
+		}
+		return artists[i].Popularity > artists[j].Popularity
+	})
+	if limit > 0 && len(artists) > limit {
+		artists = artists[:limit]
+	}
+	return artists, nil
+}
+
+// foldToLowerASCII lowercases s and strips diacritics and punctuation,
+// so that names like "Beyoncé" and "beyonce" compare as equal.
+func foldToLowerASCII(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		r = foldRune(r)
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// foldRune maps a rune to its lowercase, diacritic-free ASCII
+// equivalent where one exists.  Other ASCII runes are only lowercased;
+// other non-ASCII runes become a space.
+func foldRune(r rune) rune {
+	r = unicode.ToLower(r)
+	if replacement, ok := asciiFolds[r]; ok {
+		return replacement
+	}
+	if r > unicode.MaxASCII {
+		return ' '
+	}
+	return r
+}
+
+// asciiFolds maps common accented lowercase runes to their plain
+// ASCII equivalent for fuzzy name comparisons.
+var asciiFolds = map[rune]rune{
+	'à': 'a', 'á': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a',
+	'è': 'e', 'é': 'e', 'ê': 'e', 'ë': 'e',
+	'ì': 'i', 'í': 'i', 'î': 'i', 'ï': 'i',
+	'ò': 'o', 'ó': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o', 'ø': 'o',
+	'ù': 'u', 'ú': 'u', 'û': 'u', 'ü': 'u',
+	'ý': 'y', 'ÿ': 'y',
+	'ñ': 'n', 'ç': 'c',
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of s1 and s2, a value
+// between 0 (no similarity) and 1 (identical strings).
+func jaroWinkler(s1, s2 string) float64 {
+	jaro := jaroSimilarity(s1, s2)
+	if jaro == 0 {
+		return 0
+	}
+
+	const (
+		prefixScale  = 0.1
+		maxPrefixLen = 4
+	)
+	prefixLen := 0
+	r1, r2 := []rune(s1), []rune(s2)
+	for prefixLen < len(r1) && prefixLen < len(r2) && prefixLen < maxPrefixLen && r1[prefixLen] == r2[prefixLen] {
+		prefixLen++
+	}
+	return jaro + float64(prefixLen)*prefixScale*(1-jaro)
+}
+
+// jaroSimilarity returns the Jaro similarity of s1 and s2.
+func jaroSimilarity(s1, s2 string) float64 {
+	r1, r2 := []rune(s1), []rune(s2)
+	if len(r1) == 0 && len(r2) == 0 {
+		return 1
+	}
+	if len(r1) == 0 || len(r2) == 0 {
+		return 0
+	}
+
+	matchDistance := max(len(r1), len(r2))/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	r1Matches := make([]bool, len(r1))
+	r2Matches := make([]bool, len(r2))
+
+	matches := 0
+	for i := range r1 {
+		start := max(0, i-matchDistance)
+		end := min(len(r2), i+matchDistance+1)
+		for j := start; j < end; j++ {
+			if r2Matches[j] || r1[i] != r2[j] {
+				continue
+			}
+			r1Matches[i] = true
+			r2Matches[j] = true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := range r1 {
+		if !r1Matches[i] {
+			continue
+		}
+		for !r2Matches[k] {
+			k++
+		}
+		if r1[i] != r2[k] {
+			transpositions++
+		}
+		k++
+	}
+	transpositions /= 2
+
+	m := float64(matches)
+	return (m/float64(len(r1)) + m/float64(len(r2)) + (m-float64(transpositions))/m) / 3
+}
@@ -0,0 +1,134 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how a Client retries requests that are rate
+// limited (HTTP 429) or fail with a server error (5xx).
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is attempted,
+	// including the initial try.  A value of 0 causes the Client to fall
+	// back to DefaultRetryPolicy; a value of 1 disables retries.
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry when the server
+	// does not supply a Retry-After header.  It doubles on each
+	// subsequent attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential BaseBackoff delay used when a
+	// response doesn't carry a usable Retry-After header.  It does not
+	// shorten an explicit Retry-After value, since that's Spotify
+	// telling us exactly how long it intends to keep throttling us.
+	MaxBackoff time.Duration
+	// Jitter, if true, randomizes each computed backoff delay by up to
+	// +/- 50% so that concurrent callers don't retry in lockstep.
+	Jitter bool
+}
+
+// DefaultRetryPolicy is used by a Client that has not called
+// SetRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  8 * time.Second,
+	Jitter:      true,
+}
+
+// SetRetryPolicy configures how c retries requests that come back rate
+// limited or with a server error.  Long-running scrapers - the kind that
+// walk every artist's discography - should raise MaxAttempts so they
+// don't die on the first throttle.
+//
+// As with the rest of Client's configuration, SetRetryPolicy is meant
+// to be called once during setup, before c is shared across goroutines.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// doGet issues a GET request against uri, retrying according to
+// c.retryPolicy when Spotify responds with 429 (honoring the
+// Retry-After header when present) or a 5xx error.  It is the common
+// entry point used by FindArtist, FindArtists, ArtistsTopTracks,
+// FindRelatedArtists, and ArtistAlbumsOpt.
+func (c *Client) doGet(uri string) (*http.Response, error) {
+	policy := c.retryPolicy
+	if policy.MaxAttempts == 0 {
+		policy = DefaultRetryPolicy
+	}
+
+	backoff := policy.BaseBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := c.http.Get(uri)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+		if attempt >= policy.MaxAttempts {
+			return resp, nil
+		}
+
+		wait, retryAfter := backoff, false
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				wait, retryAfter = d, true
+			}
+		}
+		// An explicit Retry-After is an instruction from Spotify about
+		// exactly how long it will keep throttling us; only the
+		// exponential fallback is subject to MaxBackoff.
+		if !retryAfter && policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+			wait = policy.MaxBackoff
+		}
+		if policy.Jitter && !retryAfter {
+			wait = jitter(wait)
+		}
+
+		resp.Body.Close()
+		time.Sleep(wait)
+
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+// parseRetryAfter parses a Retry-After header expressed in seconds. It
+// reports false if the header is absent or malformed.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// jitter randomizes d by up to +/- 50%.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
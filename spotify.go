@@ -0,0 +1,35 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import "net/http"
+
+// Client is a client for working with the Spotify Web API.
+type Client struct {
+	http http.Client
+
+	// retryPolicy controls how doGet retries rate-limited and
+	// server-error responses. See SetRetryPolicy.
+	retryPolicy RetryPolicy
+	// batchConcurrency bounds how many chunk requests
+	// FindArtistsBatch has in flight at once. See SetBatchConcurrency.
+	batchConcurrency int
+}
+
+// DefaultClient is the default, unauthenticated Client used by the
+// package-level convenience functions such as FindArtist and
+// FindArtists.  Callers that need authenticated access should construct
+// their own Client instead, e.g. via NewClientCredentialsClient.
+var DefaultClient = &Client{}